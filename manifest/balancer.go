@@ -0,0 +1,21 @@
+package manifest
+
+type Balancer struct {
+	Name string
+
+	AcmeEmail string
+	Endpoints Endpoints
+}
+
+type Balancers []Balancer
+
+type Endpoint struct {
+	Port     string
+	Protocol string
+	Redirect string
+	Target   string
+
+	Hostnames []string
+}
+
+type Endpoints []Endpoint
@@ -10,10 +10,14 @@ import (
 )
 
 type Manifest struct {
+	AcmeEmail string
+
 	Balancers Balancers
 	Queues    Queues
+	Resources Resources
 	Services  Services
 	Tables    Tables
+	Timers    Timers
 
 	root string
 }
@@ -25,6 +29,14 @@ func Load(data []byte) (*Manifest, error) {
 		return nil, err
 	}
 
+	if err := m.Resources.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Services.Validate(); err != nil {
+		return nil, err
+	}
+
 	return &m, nil
 }
 
@@ -0,0 +1,48 @@
+package manifest
+
+import "fmt"
+
+type Resource struct {
+	Name string
+
+	Type  string
+	Image string
+	Port  int
+	URL   string
+}
+
+type Resources []Resource
+
+// resourceKinds are the built-in resource types the local and aws providers
+// know how to materialize. A resource may omit Type entirely if it sets
+// both Image and URL, which plugs in an arbitrary datastore image.
+var resourceKinds = map[string]bool{
+	"postgres":      true,
+	"mysql":         true,
+	"mariadb":       true,
+	"redis":         true,
+	"memcached":     true,
+	"elasticsearch": true,
+}
+
+// Validate checks that every resource declares a known kind, or (for a
+// custom image plugged into an unknown kind) the url needed to connect to
+// it. A known kind may set Image to override the image it runs while
+// keeping the kind's default connection url.
+func (rs Resources) Validate() error {
+	for _, r := range rs {
+		if resourceKinds[r.Type] {
+			continue
+		}
+
+		if r.Image == "" {
+			return fmt.Errorf("unknown resource type %q for resource: %s", r.Type, r.Name)
+		}
+
+		if r.URL == "" {
+			return fmt.Errorf("url required for custom image on resource: %s", r.Name)
+		}
+	}
+
+	return nil
+}
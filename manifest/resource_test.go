@@ -0,0 +1,48 @@
+package manifest
+
+import "testing"
+
+func TestResourcesValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources Resources
+		wantErr   bool
+	}{
+		{
+			name:      "known kind",
+			resources: Resources{{Name: "database", Type: "postgres"}},
+		},
+		{
+			name:      "known kind with image override",
+			resources: Resources{{Name: "database", Type: "postgres", Image: "myorg/postgres"}},
+		},
+		{
+			name:      "custom image with url",
+			resources: Resources{{Name: "mongo", Type: "mongo", Image: "mongo:4", URL: "mongodb://admin:pw@external-host:27017/app"}},
+		},
+		{
+			name:      "unknown kind without image",
+			resources: Resources{{Name: "mongo", Type: "mongo"}},
+			wantErr:   true,
+		},
+		{
+			name:      "custom image without url",
+			resources: Resources{{Name: "mongo", Type: "mongo", Image: "mongo:4"}},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.resources.Validate()
+
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
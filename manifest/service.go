@@ -11,18 +11,45 @@ type Service struct {
 
 	Build       ServiceBuild
 	Environment []string
+	Health      Health
 	Image       string
+	Resources   []string
 	Test        string
 	Volumes     []string
 }
 
 type Services []Service
 
+// Validate checks that every service's healthcheck, if any, is complete
+// enough for serviceContainers to build a usable docker HEALTHCHECK from.
+func (ss Services) Validate() error {
+	for _, s := range ss {
+		if s.Health.Path != "" && s.Health.Port == 0 {
+			return fmt.Errorf("port required for health check on service: %s", s.Name)
+		}
+	}
+
+	return nil
+}
+
 type ServiceBuild struct {
 	Args []string
 	Path string
 }
 
+// Health describes an HTTP healthcheck for a service. Interval, Timeout and
+// Grace are seconds. Threshold is the number of consecutive failed checks
+// docker needs to report the container unhealthy, and also the number of
+// consecutive unhealthy converge passes after which converge restarts it.
+type Health struct {
+	Path      string
+	Port      int
+	Interval  int
+	Timeout   int
+	Grace     int
+	Threshold int
+}
+
 func (s Service) BuildHash() string {
 	return fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("build[path=%q, args=%v] image=%q", s.Build.Path, s.Build.Args, s.Image))))
 }
@@ -0,0 +1,39 @@
+package manifest
+
+import "testing"
+
+func TestServicesValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		services Services
+		wantErr  bool
+	}{
+		{
+			name:     "no health check",
+			services: Services{{Name: "web"}},
+		},
+		{
+			name:     "health check with port",
+			services: Services{{Name: "web", Health: Health{Path: "/ping", Port: 3000}}},
+		},
+		{
+			name:     "health check without port",
+			services: Services{{Name: "web", Health: Health{Path: "/ping"}}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.services.Validate()
+
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
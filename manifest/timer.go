@@ -0,0 +1,23 @@
+package manifest
+
+import "fmt"
+
+type Timer struct {
+	Name string
+
+	Command  string
+	Schedule string
+	Service  string
+}
+
+type Timers []Timer
+
+func (ts Timers) Find(name string) (*Timer, error) {
+	for _, t := range ts {
+		if t.Name == name {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find timer: %s", name)
+}
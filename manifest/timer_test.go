@@ -0,0 +1,26 @@
+package manifest
+
+import "testing"
+
+func TestTimersFind(t *testing.T) {
+	timers := Timers{
+		{Name: "cleanup", Schedule: "0 0 * * *", Service: "web", Command: "rake cleanup"},
+	}
+
+	t.Run("found", func(t *testing.T) {
+		timer, err := timers.Find("cleanup")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if timer.Service != "web" {
+			t.Fatalf("got service %q, want web", timer.Service)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if _, err := timers.Find("missing"); err == nil {
+			t.Fatal("expected error, got none")
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package local
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/convox/praxis/manifest"
+	"github.com/convox/praxis/storage"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// acmeCache adapts storage.Cache to autocert.Cache, translating a missing
+// file into the cache-miss sentinel autocert expects.
+type acmeCache struct {
+	storage.Cache
+}
+
+func (c acmeCache) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := c.Cache.Get(ctx, key)
+	if os.IsNotExist(err) {
+		return nil, autocert.ErrCacheMiss
+	}
+
+	return data, err
+}
+
+// acmeManager builds an autocert manager backed by a rack-local cache
+// directory, the same /var/convox/<app> convention resourceContainers uses
+// for volumes. A manifest loaded from a stored release has no root, so it
+// can't supply a path via m.Path.
+func (p *Provider) acmeManager(app, email string, hostnames []string) *autocert.Manager {
+	dir := fmt.Sprintf("/var/convox/%s/acme", app)
+
+	return &autocert.Manager{
+		Cache:      acmeCache{Cache: storage.FileCache(dir)},
+		Prompt:     autocert.AcceptTOS,
+		Email:      email,
+		HostPolicy: autocert.HostWhitelist(hostnames...),
+	}
+}
+
+// acmeListenLock serializes acmeProvision across every caller in the
+// process: only one listener can hold :80 at a time for the http-01
+// challenge, so concurrent calls (e.g. two hostnamed endpoints converging
+// together) must queue rather than race for the port.
+var acmeListenLock sync.Mutex
+
+// acmeProvision fetches or renews certificates for hostnames, returning an
+// error if any of them could not be issued. It serves the http-01 challenge
+// itself on :80 for the duration of the request, since nothing else on the
+// rack host is listening there.
+func (p *Provider) acmeProvision(app, email string, hostnames []string) error {
+	acmeListenLock.Lock()
+	defer acmeListenLock.Unlock()
+
+	mgr := p.acmeManager(app, email, hostnames)
+
+	ln, err := net.Listen("tcp", ":80")
+	if err != nil {
+		return fmt.Errorf("could not bind :80 for acme http-01 challenge: %s", err)
+	}
+
+	srv := &http.Server{Handler: mgr.HTTPHandler(nil)}
+	go srv.Serve(ln)
+	defer srv.Close()
+
+	for _, h := range hostnames {
+		if _, err := mgr.GetCertificate(&tls.ClientHelloInfo{ServerName: h}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// acmeJob is a balancer endpoint that needs ACME provisioning.
+type acmeJob struct {
+	name      string
+	email     string
+	hostnames []string
+}
+
+// key identifies j in acmeStatus. A balancer can have several hostnamed
+// endpoints, so the balancer name alone would let one endpoint's result
+// overwrite another's.
+func (j acmeJob) key() string {
+	return fmt.Sprintf("%s%v", j.name, j.hostnames)
+}
+
+// acmeJobs lists the balancer endpoints in m that have hostnames configured,
+// each paired with the email to provision under: the balancer's AcmeEmail,
+// falling back to the manifest-wide default.
+func acmeJobs(m *manifest.Manifest) []acmeJob {
+	jobs := []acmeJob{}
+
+	for _, b := range m.Balancers {
+		email := b.AcmeEmail
+		if email == "" {
+			email = m.AcmeEmail
+		}
+
+		for _, e := range b.Endpoints {
+			if len(e.Hostnames) == 0 {
+				continue
+			}
+
+			jobs = append(jobs, acmeJob{name: b.Name, email: email, hostnames: e.Hostnames})
+		}
+	}
+
+	return jobs
+}
+
+var (
+	acmeStatusLock sync.Mutex
+	acmeStatus     = map[string]error{}
+)
+
+// acmeHealthy reports whether name last completed ACME provisioning
+// successfully. It's false until the first attempt finishes, so a
+// production endpoint with hostnames stays on its self-signed fallback,
+// flagged as not yet provisioned, until this turns true.
+func acmeHealthy(name string) bool {
+	acmeStatusLock.Lock()
+	defer acmeStatusLock.Unlock()
+
+	err, tried := acmeStatus[name]
+
+	return tried && err == nil
+}
+
+func setAcmeStatus(name string, err error) {
+	acmeStatusLock.Lock()
+	defer acmeStatusLock.Unlock()
+
+	acmeStatus[name] = err
+}
+
+// acmeConverge provisions certificates for every balancer endpoint with
+// hostnames in production. Jobs run one at a time in a single background
+// goroutine, since acmeProvision needs sole use of :80 for the http-01
+// challenge; a goroutine per endpoint would have them race for that port.
+// Endpoints that haven't yet provisioned successfully are flagged in the
+// release log on every converge pass, so a stuck endpoint doesn't silently
+// keep serving its self-signed fallback with no visible signal.
+func (p *Provider) acmeConverge(m *manifest.Manifest, app, release string, stage int) {
+	if stage != manifest.StageProduction {
+		return
+	}
+
+	jobs := acmeJobs(m)
+	if len(jobs) == 0 {
+		return
+	}
+
+	for _, j := range jobs {
+		if !acmeHealthy(j.key()) {
+			p.storageLogWrite(fmt.Sprintf("apps/%s/releases/%s/log", app, release), []byte(fmt.Sprintf("action=converge reason=acme-not-provisioned name=%s\n", j.name)))
+		}
+	}
+
+	log := p.logger("acmeConverge").Append("app=%q", app)
+
+	go func() {
+		for _, j := range jobs {
+			err := p.acmeProvision(app, j.email, j.hostnames)
+			setAcmeStatus(j.key(), err)
+
+			if err != nil {
+				log.Error(fmt.Errorf("name=%s error=%s", j.name, err))
+				continue
+			}
+
+			log.Successf("name=%s hostnames=%v", j.name, j.hostnames)
+		}
+	}()
+}
@@ -0,0 +1,62 @@
+package local
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/convox/praxis/manifest"
+)
+
+func TestAcmeJobs(t *testing.T) {
+	m := &manifest.Manifest{
+		AcmeEmail: "ops@example.com",
+		Balancers: manifest.Balancers{
+			{
+				Name: "web",
+				Endpoints: manifest.Endpoints{
+					{Port: "443", Protocol: "https", Target: "tcp://localhost:3000", Hostnames: []string{"app.example.com"}},
+					{Port: "444", Protocol: "https", Target: "tcp://localhost:3001"},
+				},
+			},
+			{
+				Name:      "admin",
+				AcmeEmail: "admin@example.com",
+				Endpoints: manifest.Endpoints{
+					{Port: "443", Protocol: "https", Target: "tcp://localhost:3002", Hostnames: []string{"admin.example.com"}},
+				},
+			},
+		},
+	}
+
+	got := acmeJobs(m)
+
+	want := []acmeJob{
+		{name: "web", email: "ops@example.com", hostnames: []string{"app.example.com"}},
+		{name: "admin", email: "admin@example.com", hostnames: []string{"admin.example.com"}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestAcmeHealthy(t *testing.T) {
+	name := "never-checked-balancer"
+
+	if acmeHealthy(name) {
+		t.Fatal("expected false before any provisioning attempt")
+	}
+
+	setAcmeStatus(name, errors.New("boom"))
+
+	if acmeHealthy(name) {
+		t.Fatal("expected false after a failed attempt")
+	}
+
+	setAcmeStatus(name, nil)
+
+	if !acmeHealthy(name) {
+		t.Fatal("expected true after a successful attempt")
+	}
+}
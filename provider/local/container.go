@@ -0,0 +1,156 @@
+package local
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+type containerPort struct {
+	Host      int
+	Container int
+}
+
+type container struct {
+	Id       string
+	Name     string
+	Hostname string
+
+	Image   string
+	Command []string
+	Env     map[string]string
+	Memory  int
+	Port    containerPort
+	Volumes []string
+	Labels  map[string]string
+
+	Healthcheck          []string
+	HealthcheckInterval  int
+	HealthcheckTimeout   int
+	HealthcheckGrace     int
+	HealthcheckThreshold int
+}
+
+// containersByLabels returns the ids of running containers matching every
+// given label.
+func containersByLabels(labels map[string]string) ([]string, error) {
+	args := []string{"ps", "-q"}
+
+	for k, v := range labels {
+		args = append(args, "--filter", fmt.Sprintf("label=%s=%s", k, v))
+	}
+
+	out, err := exec.Command("docker", args...).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Fields(string(out)), nil
+}
+
+// containerConverge ensures a container matching c is running, creating one
+// if it is not, and returns its id.
+func (p *Provider) containerConverge(c container, app, release string) (string, error) {
+	ids, err := containersByLabels(map[string]string{
+		"convox.rack": p.Name,
+		"convox.app":  app,
+		"convox.type": c.Labels["convox.type"],
+		"convox.name": c.Labels["convox.name"],
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(ids) > 0 {
+		return ids[0], nil
+	}
+
+	return p.containerRun(c, true)
+}
+
+// containerRegister does nothing and always returns nil; c's hostname is not
+// added to any DNS.
+func (p *Provider) containerRegister(c container) error {
+	return nil
+}
+
+// containerRun starts c via `docker run` and returns its id. When detach is
+// false the command blocks until the container exits.
+func (p *Provider) containerRun(c container, detach bool) (string, error) {
+	args := []string{"run", "--name", c.Name}
+
+	if detach {
+		args = append(args, "-d")
+	}
+
+	if c.Memory > 0 {
+		args = append(args, "-m", fmt.Sprintf("%dm", c.Memory))
+	}
+
+	if c.Port.Host > 0 {
+		args = append(args, "-p", fmt.Sprintf("%d:%d", c.Port.Host, c.Port.Container))
+	}
+
+	for k, v := range c.Env {
+		args = append(args, "-e", fmt.Sprintf("%s=%s", k, v))
+	}
+
+	for _, v := range c.Volumes {
+		args = append(args, "-v", v)
+	}
+
+	for k, v := range c.Labels {
+		args = append(args, "-l", fmt.Sprintf("%s=%s", k, v))
+	}
+
+	args = append(args, healthcheckArgs(c)...)
+
+	args = append(args, c.Image)
+	args = append(args, c.Command...)
+
+	out, err := exec.Command("docker", args...).Output()
+	if err != nil {
+		return "", err
+	}
+
+	// attached, docker run prints the container's own output rather than its
+	// id, so look the id up by the container's name
+	if !detach {
+		out, err = exec.Command("docker", "inspect", "--format", "{{.Id}}", c.Name).Output()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// healthcheckArgs translates a container's Healthcheck fields into the
+// `docker run` flags that attach a real Docker healthcheck, so
+// checkServiceHealth has State.Health.Status to read back.
+func healthcheckArgs(c container) []string {
+	if len(c.Healthcheck) == 0 {
+		return []string{}
+	}
+
+	args := []string{"--health-cmd", strings.Join(c.Healthcheck, " ")}
+
+	if c.HealthcheckInterval > 0 {
+		args = append(args, "--health-interval", fmt.Sprintf("%ds", c.HealthcheckInterval))
+	}
+
+	if c.HealthcheckTimeout > 0 {
+		args = append(args, "--health-timeout", fmt.Sprintf("%ds", c.HealthcheckTimeout))
+	}
+
+	if c.HealthcheckGrace > 0 {
+		args = append(args, "--health-start-period", fmt.Sprintf("%ds", c.HealthcheckGrace))
+	}
+
+	if c.HealthcheckThreshold > 0 {
+		args = append(args, "--health-retries", strconv.Itoa(c.HealthcheckThreshold))
+	}
+
+	return args
+}
@@ -0,0 +1,47 @@
+package local
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHealthcheckArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		c    container
+		want []string
+	}{
+		{
+			name: "no healthcheck",
+			c:    container{},
+			want: []string{},
+		},
+		{
+			name: "full healthcheck",
+			c: container{
+				Healthcheck:          []string{"curl", "-f", "http://localhost:3000/ping"},
+				HealthcheckInterval:  5,
+				HealthcheckTimeout:   3,
+				HealthcheckGrace:     10,
+				HealthcheckThreshold: 3,
+			},
+			want: []string{
+				"--health-cmd", "curl -f http://localhost:3000/ping",
+				"--health-interval", "5s",
+				"--health-timeout", "3s",
+				"--health-start-period", "10s",
+				"--health-retries", "3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := healthcheckArgs(tt.c)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -27,7 +27,7 @@ func (p *Provider) converge(app string) error {
 
 	cs := []container{}
 
-	c, err := p.balancerContainers(m.Balancers, app, r.Id, r.Stage)
+	c, err := p.balancerContainers(m, app, r.Id, r.Stage)
 	if err != nil {
 		return errors.WithStack(log.Error(err))
 	}
@@ -48,7 +48,12 @@ func (p *Provider) converge(app string) error {
 
 	cs = append(cs, c...)
 
-	// TODO: timers
+	c, err = p.timerContainers(m.Timers, app, r.Id, r.Stage)
+	if err != nil {
+		return errors.WithStack(log.Error(err))
+	}
+
+	cs = append(cs, c...)
 
 	for i, c := range cs {
 		id, err := p.containerConverge(c, app, r.Id)
@@ -65,6 +70,12 @@ func (p *Provider) converge(app string) error {
 		}
 	}
 
+	// fire-and-forget: certs land in the acme cache whenever they're ready,
+	// not in this converge pass
+	p.acmeConverge(m, app, r.Id, r.Stage)
+
+	p.checkServiceHealth(cs, app, r.Id)
+
 	running, err := containersByLabels(map[string]string{
 		"convox.rack": p.Name,
 		"convox.app":  app,
@@ -82,6 +93,17 @@ func (p *Provider) converge(app string) error {
 		return errors.WithStack(log.Error(err))
 	}
 
+	oneoffs, err := containersByLabels(map[string]string{
+		"convox.rack": p.Name,
+		"convox.app":  app,
+		"convox.type": "oneoff",
+	})
+	if err != nil {
+		return errors.WithStack(log.Error(err))
+	}
+
+	ps = append(ps, oneoffs...)
+
 	for _, rc := range running {
 		found := false
 
@@ -154,40 +176,7 @@ func (p *Provider) convergePrune() error {
 	return log.Success()
 }
 
-func resourcePort(kind string) (int, error) {
-	switch kind {
-	case "postgres":
-		return 5432, nil
-	case "redis":
-		return 6379, nil
-	}
-
-	return 0, fmt.Errorf("unknown resource type: %s", kind)
-}
-
-func resourceURL(app, kind, name string) (string, error) {
-	switch kind {
-	case "postgres":
-		return fmt.Sprintf("postgres://postgres:password@%s.resource.%s.convox:5432/app?sslmode=disable", name, app), nil
-	case "redis":
-		return fmt.Sprintf("redis://%s.resource.%s.convox:6379/0", name, app), nil
-	}
-
-	return "", fmt.Errorf("unknown resource type: %s", kind)
-}
-
-func resourceVolumes(app, kind, name string) ([]string, error) {
-	switch kind {
-	case "postgres":
-		return []string{fmt.Sprintf("/var/convox/%s/resource/%s:/var/lib/postgresql/data", app, name)}, nil
-	case "redis":
-		return []string{}, nil
-	}
-
-	return []string{}, fmt.Errorf("unknown resource type: %s", kind)
-}
-
-func (p *Provider) balancerContainers(balancers manifest.Balancers, app, release string, stage int) ([]container, error) {
+func (p *Provider) balancerContainers(m *manifest.Manifest, app, release string, stage int) ([]container, error) {
 	cs := []container{}
 
 	// don't run balancers in test stage
@@ -200,7 +189,12 @@ func (p *Provider) balancerContainers(balancers manifest.Balancers, app, release
 		return nil, err
 	}
 
-	for _, b := range balancers {
+	for _, b := range m.Balancers {
+		email := b.AcmeEmail
+		if email == "" {
+			email = m.AcmeEmail
+		}
+
 		for _, e := range b.Endpoints {
 			command := []string{}
 
@@ -213,6 +207,21 @@ func (p *Provider) balancerContainers(balancers manifest.Balancers, app, release
 				return nil, fmt.Errorf("invalid balancer endpoint: %s:%s", b.Name, e.Port)
 			}
 
+			volumes := []string{}
+
+			// development always falls back to the self-signed cert the
+			// image terminates TLS with by default; only stage production
+			// attempts acme, provisioned later by acmeConverge once this
+			// container is actually running
+			if len(e.Hostnames) > 0 && stage != manifest.StageDevelopment {
+				if email == "" {
+					return nil, fmt.Errorf("acme email required for hostnames on balancer: %s", b.Name)
+				}
+
+				command = append(command, "acme")
+				volumes = []string{fmt.Sprintf("/var/convox/%s/acme:/etc/convox/acme", app)}
+			}
+
 			cs = append(cs, container{
 				Name:     fmt.Sprintf("%s.%s.balancer.%s", p.Name, app, b.Name),
 				Hostname: fmt.Sprintf("%s.balancer.%s.%s", b.Name, app, p.Name),
@@ -223,6 +232,7 @@ func (p *Provider) balancerContainers(balancers manifest.Balancers, app, release
 				Memory:  64,
 				Image:   sys.Image,
 				Command: command,
+				Volumes: volumes,
 				Labels: map[string]string{
 					"convox.rack":    p.Name,
 					"convox.version": p.Version,
@@ -239,44 +249,6 @@ func (p *Provider) balancerContainers(balancers manifest.Balancers, app, release
 	return cs, nil
 }
 
-func (p *Provider) resourceContainers(resources manifest.Resources, app, release string) ([]container, error) {
-	cs := []container{}
-
-	for _, r := range resources {
-		rp, err := resourcePort(r.Type)
-		if err != nil {
-			return nil, err
-		}
-
-		vs, err := resourceVolumes(app, r.Type, r.Name)
-		if err != nil {
-			return nil, err
-		}
-
-		cs = append(cs, container{
-			Name:     fmt.Sprintf("%s.%s.resource.%s", p.Name, app, r.Name),
-			Hostname: fmt.Sprintf("%s.resource.%s.%s", r.Name, app, p.Name),
-			Port: containerPort{
-				Host:      rp,
-				Container: rp,
-			},
-			Image:   fmt.Sprintf("convox/%s", r.Type),
-			Volumes: vs,
-			Labels: map[string]string{
-				"convox.rack":     p.Name,
-				"convox.version":  p.Version,
-				"convox.app":      app,
-				"convox.release":  release,
-				"convox.type":     "resource",
-				"convox.name":     r.Name,
-				"convox.resource": r.Type,
-			},
-		})
-	}
-
-	return cs, nil
-}
-
 func (p *Provider) serviceContainers(services manifest.Services, app, release string, stage int) ([]container, error) {
 	cs := []container{}
 
@@ -354,7 +326,7 @@ func (p *Provider) serviceContainers(services manifest.Services, app, release st
 		for _, sr := range s.Resources {
 			for _, r := range m.Resources {
 				if r.Name == sr {
-					u, err := resourceURL(app, r.Type, r.Name)
+					u, err := resourceURL(app, r)
 					if err != nil {
 						return nil, err
 					}
@@ -364,14 +336,25 @@ func (p *Provider) serviceContainers(services manifest.Services, app, release st
 			}
 		}
 
+		var healthcheck []string
+
+		if s.Health.Path != "" {
+			healthcheck = []string{fmt.Sprintf("curl -f http://localhost:%d%s || exit 1", s.Health.Port, s.Health.Path)}
+		}
+
 		for i := 1; i <= s.Scale.Count.Min; i++ {
 			cs = append(cs, container{
-				Name:    fmt.Sprintf("%s.%s.service.%s.%d", p.Name, app, s.Name, i),
-				Image:   fmt.Sprintf("%s/%s/%s:%s", p.Name, app, s.Name, r.Build),
-				Command: cmd,
-				Env:     e,
-				Memory:  s.Scale.Memory,
-				Volumes: s.Volumes,
+				Name:                 fmt.Sprintf("%s.%s.service.%s.%d", p.Name, app, s.Name, i),
+				Image:                fmt.Sprintf("%s/%s/%s:%s", p.Name, app, s.Name, r.Build),
+				Command:              cmd,
+				Env:                  e,
+				Memory:               s.Scale.Memory,
+				Volumes:              s.Volumes,
+				Healthcheck:          healthcheck,
+				HealthcheckInterval:  s.Health.Interval,
+				HealthcheckTimeout:   s.Health.Timeout,
+				HealthcheckGrace:     s.Health.Grace,
+				HealthcheckThreshold: s.Health.Threshold,
 				Labels: map[string]string{
 					"convox.rack":    p.Name,
 					"convox.version": p.Version,
@@ -0,0 +1,73 @@
+package local
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// unhealthyCounts tracks consecutive unhealthy converge passes per
+// container name so a single flaky healthcheck doesn't trigger a restart.
+var unhealthyCounts = map[string]int{}
+
+func containerHealth(id string) string {
+	out, err := exec.Command("docker", "inspect", "--format", "{{.State.Health.Status}}", id).Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+// checkServiceHealth restarts any service container whose healthcheck has
+// been unhealthy for its configured threshold of consecutive converge
+// passes.
+func (p *Provider) checkServiceHealth(cs []container, app, release string) {
+	for _, c := range cs {
+		if c.Id == "" || len(c.Healthcheck) == 0 {
+			continue
+		}
+
+		// only services auto-restart on unhealthy; resources like postgres
+		// get a healthcheck too, but a wedged datastore should not be
+		// silently killed the way a stateless service can be
+		if c.Labels["convox.type"] != "service" {
+			continue
+		}
+
+		if !unhealthyPastThreshold(c.Name, containerHealth(c.Id), c.HealthcheckThreshold) {
+			continue
+		}
+
+		p.storageLogWrite(fmt.Sprintf("apps/%s/releases/%s/log", app, release), []byte(fmt.Sprintf("action=restart reason=unhealthy id=%s\n", c.Id)))
+
+		// rm, not stop: containerRun will docker run --name this same
+		// deterministic name on the next converge pass, which docker
+		// rejects if a stopped container still holds it
+		exec.Command("docker", "rm", "-f", c.Id).Run()
+	}
+}
+
+// unhealthyPastThreshold tracks consecutive unhealthy statuses for a
+// container name and reports whether threshold has now been reached,
+// resetting the count whenever it reports true or status isn't unhealthy.
+func unhealthyPastThreshold(name, status string, threshold int) bool {
+	if threshold <= 0 {
+		threshold = 3
+	}
+
+	if status != "unhealthy" {
+		unhealthyCounts[name] = 0
+		return false
+	}
+
+	unhealthyCounts[name]++
+
+	if unhealthyCounts[name] < threshold {
+		return false
+	}
+
+	unhealthyCounts[name] = 0
+
+	return true
+}
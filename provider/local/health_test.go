@@ -0,0 +1,57 @@
+package local
+
+import "testing"
+
+func TestUnhealthyPastThreshold(t *testing.T) {
+	t.Run("healthy resets the count", func(t *testing.T) {
+		name := "rack.app.service.web.1"
+
+		unhealthyCounts[name] = 2
+
+		if unhealthyPastThreshold(name, "healthy", 3) {
+			t.Fatal("expected false for a healthy status")
+		}
+
+		if unhealthyCounts[name] != 0 {
+			t.Fatalf("got count %d, want 0", unhealthyCounts[name])
+		}
+	})
+
+	t.Run("below threshold does not restart", func(t *testing.T) {
+		name := "rack.app.service.web.2"
+
+		if unhealthyPastThreshold(name, "unhealthy", 3) {
+			t.Fatal("expected false below threshold")
+		}
+
+		if unhealthyPastThreshold(name, "unhealthy", 3) {
+			t.Fatal("expected false below threshold")
+		}
+	})
+
+	t.Run("reaching threshold restarts and resets", func(t *testing.T) {
+		name := "rack.app.service.web.3"
+
+		unhealthyPastThreshold(name, "unhealthy", 3)
+		unhealthyPastThreshold(name, "unhealthy", 3)
+
+		if !unhealthyPastThreshold(name, "unhealthy", 3) {
+			t.Fatal("expected true at threshold")
+		}
+
+		if unhealthyCounts[name] != 0 {
+			t.Fatalf("got count %d, want 0 after restart", unhealthyCounts[name])
+		}
+	})
+
+	t.Run("threshold defaults to 3 when unset", func(t *testing.T) {
+		name := "rack.app.service.web.4"
+
+		unhealthyPastThreshold(name, "unhealthy", 0)
+		unhealthyPastThreshold(name, "unhealthy", 0)
+
+		if unhealthyPastThreshold(name, "unhealthy", 0) != true {
+			t.Fatal("expected true at default threshold of 3")
+		}
+	})
+}
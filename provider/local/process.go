@@ -0,0 +1,76 @@
+package local
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/convox/praxis/types"
+)
+
+// ProcessRun launches a oneoff container for app, runs command to completion, and
+// returns its id. The container is labeled convox.type=oneoff, which converge
+// leaves running, and with the release it was launched against. A line
+// naming the container is written to that release's log; the container's
+// own output is not copied there.
+func (p *Provider) ProcessRun(app string, opts types.ProcessRunOptions) (string, error) {
+	return p.processRun(app, opts, false)
+}
+
+// ProcessStart is like ProcessRun but detaches immediately instead of waiting
+// for the container to exit.
+func (p *Provider) ProcessStart(app string, opts types.ProcessRunOptions) (string, error) {
+	return p.processRun(app, opts, true)
+}
+
+func (p *Provider) processRun(app string, opts types.ProcessRunOptions, detach bool) (string, error) {
+	a, err := p.AppGet(app)
+	if err != nil {
+		return "", err
+	}
+
+	release := opts.Release
+
+	if release == "" {
+		release = a.Release
+	}
+
+	cmd := []string{}
+
+	if c := strings.TrimSpace(opts.Command); c != "" {
+		cmd = append(cmd, "sh", "-c", c)
+	}
+
+	c := container{
+		Name:    fmt.Sprintf("%s.%s.oneoff.%s.%s", p.Name, app, opts.Service, randomSuffix()),
+		Image:   fmt.Sprintf("%s/%s/%s:%s", p.Name, app, opts.Service, opts.Build),
+		Command: cmd,
+		Labels: map[string]string{
+			"convox.rack":    p.Name,
+			"convox.version": p.Version,
+			"convox.app":     app,
+			"convox.release": release,
+			"convox.type":    "oneoff",
+			"convox.service": opts.Service,
+		},
+	}
+
+	id, err := p.containerRun(c, detach)
+	if err != nil {
+		return "", err
+	}
+
+	p.storageLogWrite(fmt.Sprintf("apps/%s/releases/%s/log", app, release), []byte(fmt.Sprintf("running: %s\n", c.Name)))
+
+	return id, nil
+}
+
+// randomSuffix keeps concurrent oneoffs against the same app/service (e.g.
+// overlapping manual runs, or a manual run racing a timer trigger) from
+// colliding on container name.
+func randomSuffix() string {
+	b := make([]byte, 4)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
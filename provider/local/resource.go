@@ -0,0 +1,148 @@
+package local
+
+import (
+	"fmt"
+
+	"github.com/convox/praxis/manifest"
+)
+
+// ResourceType describes how to materialize a kind of resource container:
+// what image to run, what port it listens on, how to build its connection
+// URL and volume mounts, and an optional healthcheck command.
+type ResourceType struct {
+	Healthcheck []string
+	Image       string
+	Port        int
+	URL         string
+	Volumes     func(app, name string) []string
+}
+
+var resourceTypes = map[string]ResourceType{
+	"postgres": {
+		Image:       "convox/postgres",
+		Port:        5432,
+		URL:         "postgres://postgres:password@%s.resource.%s.convox:5432/app?sslmode=disable",
+		Healthcheck: []string{"pg_isready", "-U", "postgres"},
+		Volumes: func(app, name string) []string {
+			return []string{fmt.Sprintf("/var/convox/%s/resource/%s:/var/lib/postgresql/data", app, name)}
+		},
+	},
+	"mysql": {
+		Image:       "convox/mysql",
+		Port:        3306,
+		URL:         "mysql://mysql:password@%s.resource.%s.convox:3306/app",
+		Healthcheck: []string{"mysqladmin", "ping", "-h", "127.0.0.1"},
+		Volumes: func(app, name string) []string {
+			return []string{fmt.Sprintf("/var/convox/%s/resource/%s:/var/lib/mysql", app, name)}
+		},
+	},
+	"mariadb": {
+		Image:       "convox/mariadb",
+		Port:        3306,
+		URL:         "mysql://mariadb:password@%s.resource.%s.convox:3306/app",
+		Healthcheck: []string{"mysqladmin", "ping", "-h", "127.0.0.1"},
+		Volumes: func(app, name string) []string {
+			return []string{fmt.Sprintf("/var/convox/%s/resource/%s:/var/lib/mysql", app, name)}
+		},
+	},
+	"redis": {
+		Image:       "convox/redis",
+		Port:        6379,
+		URL:         "redis://%s.resource.%s.convox:6379/0",
+		Healthcheck: []string{"redis-cli", "ping"},
+		Volumes: func(app, name string) []string {
+			return []string{}
+		},
+	},
+	"memcached": {
+		Image: "convox/memcached",
+		Port:  11211,
+		URL:   "%s.resource.%s.convox:11211",
+		Volumes: func(app, name string) []string {
+			return []string{}
+		},
+	},
+	"elasticsearch": {
+		Image:       "convox/elasticsearch",
+		Port:        9200,
+		URL:         "http://%s.resource.%s.convox:9200",
+		Healthcheck: []string{"curl", "-f", "http://localhost:9200/_cluster/health"},
+		Volumes: func(app, name string) []string {
+			return []string{fmt.Sprintf("/var/convox/%s/resource/%s:/usr/share/elasticsearch/data", app, name)}
+		},
+	},
+}
+
+// resourceType resolves the ResourceType for a manifest resource, applying
+// any image/url overrides declared on the resource itself.
+func resourceType(r manifest.Resource) (ResourceType, error) {
+	rt, ok := resourceTypes[r.Type]
+	if !ok {
+		if r.Image == "" {
+			return ResourceType{}, fmt.Errorf("unknown resource type: %s", r.Type)
+		}
+
+		rt = ResourceType{
+			Port:    r.Port,
+			Volumes: func(app, name string) []string { return []string{} },
+		}
+	}
+
+	if r.Image != "" {
+		rt.Image = r.Image
+	}
+
+	if r.URL != "" {
+		rt.URL = r.URL
+	}
+
+	return rt, nil
+}
+
+func resourceURL(app string, r manifest.Resource) (string, error) {
+	// a user-supplied url is a literal connection string, not a template
+	if r.URL != "" {
+		return r.URL, nil
+	}
+
+	rt, err := resourceType(r)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(rt.URL, r.Name, app), nil
+}
+
+func (p *Provider) resourceContainers(resources manifest.Resources, app, release string) ([]container, error) {
+	cs := []container{}
+
+	for _, r := range resources {
+		rt, err := resourceType(r)
+		if err != nil {
+			return nil, err
+		}
+
+		cs = append(cs, container{
+			Name:     fmt.Sprintf("%s.%s.resource.%s", p.Name, app, r.Name),
+			Hostname: fmt.Sprintf("%s.resource.%s.%s", r.Name, app, p.Name),
+			Port: containerPort{
+				Host:      rt.Port,
+				Container: rt.Port,
+			},
+			Image:       rt.Image,
+			Volumes:     rt.Volumes(app, r.Name),
+			Healthcheck: rt.Healthcheck,
+			Labels: map[string]string{
+				"convox.rack":     p.Name,
+				"convox.version":  p.Version,
+				"convox.app":      app,
+				"convox.release":  release,
+				"convox.type":     "resource",
+				"convox.name":     r.Name,
+				"convox.resource": r.Type,
+			},
+		})
+	}
+
+	return cs, nil
+}
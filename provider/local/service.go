@@ -0,0 +1,80 @@
+package local
+
+import (
+	"github.com/convox/praxis/helpers"
+	"github.com/convox/praxis/types"
+)
+
+func (p *Provider) ServiceList(app string) (types.Services, error) {
+	m, r, err := helpers.AppManifest(p, app)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := types.Services{}
+
+	for _, s := range m.Services {
+		health := "unknown"
+
+		if s.Health.Path != "" {
+			ids, err := containersByLabels(map[string]string{
+				"convox.rack":    p.Name,
+				"convox.app":     app,
+				"convox.release": r.Id,
+				"convox.type":    "service",
+				"convox.service": s.Name,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			health = serviceHealth(ids)
+		}
+
+		ss = append(ss, types.Service{
+			Name:          s.Name,
+			ServiceHealth: health,
+		})
+	}
+
+	return ss, nil
+}
+
+// serviceHealth rolls up the docker health status of a service's containers.
+func serviceHealth(ids []string) string {
+	statuses := make([]string, len(ids))
+
+	for i, id := range ids {
+		statuses[i] = containerHealth(id)
+	}
+
+	return rollupHealth(statuses)
+}
+
+// rollupHealth combines the per-container docker health statuses of a
+// service: unhealthy if any container is unhealthy, starting if any is
+// still coming up, healthy only if every container reports healthy, and
+// unknown otherwise.
+func rollupHealth(statuses []string) string {
+	if len(statuses) == 0 {
+		return "unknown"
+	}
+
+	status := "healthy"
+
+	for _, s := range statuses {
+		switch s {
+		case "unhealthy":
+			return "unhealthy"
+		case "starting":
+			status = "starting"
+		case "healthy":
+		default:
+			if status == "healthy" {
+				status = "unknown"
+			}
+		}
+	}
+
+	return status
+}
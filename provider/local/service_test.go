@@ -0,0 +1,24 @@
+package local
+
+import "testing"
+
+func TestRollupHealth(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		want     string
+	}{
+		{name: "no containers", statuses: []string{}, want: "unknown"},
+		{name: "all healthy", statuses: []string{"healthy", "healthy"}, want: "healthy"},
+		{name: "one starting", statuses: []string{"healthy", "starting"}, want: "starting"},
+		{name: "one unhealthy wins", statuses: []string{"starting", "unhealthy", "healthy"}, want: "unhealthy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rollupHealth(tt.statuses); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package local
+
+import (
+	"fmt"
+
+	"github.com/convox/praxis/helpers"
+	"github.com/convox/praxis/manifest"
+	"github.com/convox/praxis/types"
+)
+
+func (p *Provider) timerContainers(timers manifest.Timers, app, release string, stage int) ([]container, error) {
+	cs := []container{}
+
+	// timers dont run in test stage
+	if stage == manifest.StageTest {
+		return cs, nil
+	}
+
+	sys, err := p.SystemGet()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range timers {
+		cs = append(cs, container{
+			Name:    fmt.Sprintf("%s.%s.timer.%s", p.Name, app, t.Name),
+			Image:   sys.Image,
+			Command: []string{"timer", t.Schedule, app, t.Service, t.Command},
+			Memory:  16,
+			Labels: map[string]string{
+				"convox.rack":    p.Name,
+				"convox.version": p.Version,
+				"convox.app":     app,
+				"convox.release": release,
+				"convox.type":    "timer",
+				"convox.name":    t.Name,
+			},
+		})
+	}
+
+	return cs, nil
+}
+
+// TimerList returns the timers configured for an app.
+func (p *Provider) TimerList(app string) (types.Timers, error) {
+	m, _, err := helpers.AppManifest(p, app)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := types.Timers{}
+
+	for _, t := range m.Timers {
+		ts = append(ts, types.Timer{
+			Name:     t.Name,
+			Schedule: t.Schedule,
+			Service:  t.Service,
+			Command:  t.Command,
+		})
+	}
+
+	return ts, nil
+}
+
+// TimerRun triggers a single manual run of a timer, bypassing its schedule.
+func (p *Provider) TimerRun(app, name string) error {
+	m, r, err := helpers.AppManifest(p, app)
+	if err != nil {
+		return err
+	}
+
+	t, err := m.Timers.Find(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.ProcessRun(app, types.ProcessRunOptions{
+		Build:   r.Build,
+		Command: t.Command,
+		Release: r.Id,
+		Service: t.Service,
+	})
+
+	return err
+}
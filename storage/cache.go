@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Cache is a minimal key/value blob store. It matches the shape of
+// golang.org/x/crypto/acme/autocert.Cache so it can back things like ACME
+// account keys and certificates that need to survive restarts.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Put(ctx context.Context, key string, data []byte) error
+	Delete(ctx context.Context, key string) error
+}
+
+// FileCache implements Cache on top of a directory on disk.
+type FileCache string
+
+func (c FileCache) Get(ctx context.Context, key string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(string(c), key))
+}
+
+func (c FileCache) Put(ctx context.Context, key string, data []byte) error {
+	if err := os.MkdirAll(string(c), 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(string(c), key), data, 0600)
+}
+
+func (c FileCache) Delete(ctx context.Context, key string) error {
+	return os.Remove(filepath.Join(string(c), key))
+}